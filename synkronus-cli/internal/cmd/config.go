@@ -0,0 +1,220 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"os/exec"
+	"sort"
+	"strings"
+
+	"github.com/HelloSapiens/collectivus/synkronus-cli/internal/output"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+var configCmd = &cobra.Command{
+	Use:   "config",
+	Short: "View and modify Synkronus CLI configuration",
+	Long:  `The config command lets you inspect and modify the settings stored in the active config file without hand-editing it.`,
+}
+
+var configListCmd = &cobra.Command{
+	Use:   "list",
+	Short: "Print all resolved configuration settings",
+	Run: func(cmd *cobra.Command, args []string) {
+		keys := viper.AllKeys()
+		sort.Strings(keys)
+
+		settings := map[string]interface{}{
+			"configFile": viper.ConfigFileUsed(),
+			"dataDir":    viper.GetString("data.dir"),
+			"settings":   map[string]interface{}{},
+		}
+		flat := settings["settings"].(map[string]interface{})
+		for _, k := range keys {
+			flat[k] = viper.Get(k)
+		}
+
+		if outputFormat() == output.Text {
+			fmt.Printf("Config file in use: %s\n", viper.ConfigFileUsed())
+			fmt.Printf("Data directory: %s\n", viper.GetString("data.dir"))
+			for _, k := range keys {
+				fmt.Printf("%s: %v\n", k, viper.Get(k))
+			}
+			return
+		}
+		printResult(settings)
+	},
+}
+
+var configGetCmd = &cobra.Command{
+	Use:   "get <key>",
+	Short: "Print the value of a single dotted config key",
+	Args:  cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		key := args[0]
+		if !viper.IsSet(key) {
+			fmt.Fprintf(os.Stderr, "key %q is not set\n", key)
+			os.Exit(1)
+		}
+		printResult(viper.Get(key))
+	},
+}
+
+var configSetCmd = &cobra.Command{
+	Use:   "set <key> <value>",
+	Short: "Set a config key and persist it to the active config file",
+	Args:  cobra.ExactArgs(2),
+	Run: func(cmd *cobra.Command, args []string) {
+		key, value := args[0], args[1]
+		if err := rewriteConfigFile(func(settings map[string]interface{}) {
+			setNestedKey(settings, key, value)
+		}); err != nil {
+			fmt.Fprintf(os.Stderr, "failed to write config: %v\n", err)
+			os.Exit(1)
+		}
+		viper.Set(key, value)
+
+		if outputFormat() == output.Text {
+			fmt.Printf("%s set to %s\n", key, value)
+			return
+		}
+		printResult(map[string]interface{}{"key": key, "value": value})
+	},
+}
+
+var configUnsetCmd = &cobra.Command{
+	Use:   "unset <key>",
+	Short: "Remove a config key from the active config file",
+	Args:  cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		key := args[0]
+
+		var unsetErr error
+		writeErr := rewriteConfigFile(func(settings map[string]interface{}) {
+			unsetErr = unsetKey(settings, key)
+		})
+		if unsetErr != nil {
+			fmt.Fprintf(os.Stderr, "failed to unset %q: %v\n", key, unsetErr)
+			os.Exit(1)
+		}
+		if writeErr != nil {
+			fmt.Fprintf(os.Stderr, "failed to write config: %v\n", writeErr)
+			os.Exit(1)
+		}
+
+		if outputFormat() == output.Text {
+			fmt.Printf("%s unset\n", key)
+			return
+		}
+		printResult(map[string]interface{}{"key": key, "unset": true})
+	},
+}
+
+var configPathCmd = &cobra.Command{
+	Use:   "path",
+	Short: "Print the path of the active config file",
+	Run: func(cmd *cobra.Command, args []string) {
+		if outputFormat() == output.Text {
+			fmt.Println(viper.ConfigFileUsed())
+			return
+		}
+		printResult(viper.ConfigFileUsed())
+	},
+}
+
+var configEnvCmd = &cobra.Command{
+	Use:   "env",
+	Short: "List recognized SYNK_ environment variables and their resolved values",
+	Run: func(cmd *cobra.Command, args []string) {
+		vars := map[string]interface{}{"SYNK_PROFILE": viper.GetString("current-profile")}
+		for _, key := range envVars {
+			vars[envVarName(key)] = viper.Get(key)
+		}
+
+		if outputFormat() == output.Text {
+			for _, key := range envVars {
+				fmt.Printf("%s=%v\n", envVarName(key), viper.Get(key))
+			}
+			fmt.Printf("SYNK_PROFILE=%v\n", viper.GetString("current-profile"))
+			return
+		}
+		printResult(vars)
+	},
+}
+
+// envVarName returns the SYNK_ environment variable name for a dotted
+// config key, e.g. "api.url" -> "SYNK_API_URL".
+func envVarName(key string) string {
+	replacer := strings.NewReplacer(".", "_", "-", "_")
+	return "SYNK_" + strings.ToUpper(replacer.Replace(key))
+}
+
+var configEditCmd = &cobra.Command{
+	Use:   "edit",
+	Short: "Open the active config file in $EDITOR",
+	Run: func(cmd *cobra.Command, args []string) {
+		editor := os.Getenv("EDITOR")
+		if editor == "" {
+			editor = "vi"
+		}
+
+		e := exec.Command(editor, viper.ConfigFileUsed())
+		e.Stdin = os.Stdin
+		e.Stdout = os.Stdout
+		e.Stderr = os.Stderr
+		if err := e.Run(); err != nil {
+			fmt.Fprintf(os.Stderr, "failed to launch editor: %v\n", err)
+			os.Exit(1)
+		}
+	},
+}
+
+// unsetKey deletes the dotted key from the nested settings map in place.
+func unsetKey(settings map[string]interface{}, key string) error {
+	parts := splitKey(key)
+	m := settings
+	for i, part := range parts {
+		if i == len(parts)-1 {
+			if _, ok := m[part]; !ok {
+				return fmt.Errorf("key not found")
+			}
+			delete(m, part)
+			return nil
+		}
+		next, ok := m[part]
+		if !ok {
+			return fmt.Errorf("key not found")
+		}
+		nextMap, ok := next.(map[string]interface{})
+		if !ok {
+			return fmt.Errorf("key not found")
+		}
+		m = nextMap
+	}
+	return nil
+}
+
+func splitKey(key string) []string {
+	var parts []string
+	start := 0
+	for i := 0; i < len(key); i++ {
+		if key[i] == '.' {
+			parts = append(parts, key[start:i])
+			start = i + 1
+		}
+	}
+	parts = append(parts, key[start:])
+	return parts
+}
+
+func init() {
+	rootCmd.AddCommand(configCmd)
+	configCmd.AddCommand(configListCmd)
+	configCmd.AddCommand(configGetCmd)
+	configCmd.AddCommand(configSetCmd)
+	configCmd.AddCommand(configUnsetCmd)
+	configCmd.AddCommand(configPathCmd)
+	configCmd.AddCommand(configEditCmd)
+	configCmd.AddCommand(configEnvCmd)
+}
@@ -0,0 +1,63 @@
+package cmd
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestSplitKey(t *testing.T) {
+	cases := map[string][]string{
+		"api.url": {"api", "url"},
+		"auth":    {"auth"},
+		"a.b.c":   {"a", "b", "c"},
+	}
+
+	for key, want := range cases {
+		if got := splitKey(key); !reflect.DeepEqual(got, want) {
+			t.Errorf("splitKey(%q) = %v, want %v", key, got, want)
+		}
+	}
+}
+
+func TestUnsetKey(t *testing.T) {
+	settings := map[string]interface{}{
+		"api": map[string]interface{}{
+			"url":     "http://localhost:8080",
+			"version": "1.0.0",
+		},
+	}
+
+	if err := unsetKey(settings, "api.url"); err != nil {
+		t.Fatalf("unsetKey returned error: %v", err)
+	}
+
+	api := settings["api"].(map[string]interface{})
+	if _, ok := api["url"]; ok {
+		t.Errorf("api.url still present after unsetKey")
+	}
+	if _, ok := api["version"]; !ok {
+		t.Errorf("api.version should be untouched by unsetting api.url")
+	}
+}
+
+func TestUnsetKeyMissing(t *testing.T) {
+	settings := map[string]interface{}{"api": map[string]interface{}{}}
+
+	if err := unsetKey(settings, "api.missing.nested"); err == nil {
+		t.Errorf("expected error unsetting a key that does not exist")
+	}
+}
+
+func TestSetNestedKey(t *testing.T) {
+	settings := map[string]interface{}{}
+
+	setNestedKey(settings, "api.url", "http://example.com")
+
+	api, ok := settings["api"].(map[string]interface{})
+	if !ok {
+		t.Fatalf("expected api to be created as a nested map, got %#v", settings["api"])
+	}
+	if api["url"] != "http://example.com" {
+		t.Errorf("api.url = %v, want http://example.com", api["url"])
+	}
+}
@@ -0,0 +1,52 @@
+package cmd
+
+import "github.com/spf13/viper"
+
+// rewriteConfigFile mutates the on-disk config file directly: it loads a
+// clean snapshot of just the file's own contents (ignoring bound flags, env
+// vars, and any in-memory overlay such as the active profile), lets mutate
+// edit that snapshot, and writes the result back verbatim.
+//
+// This deliberately avoids viper.WriteConfig() on the global instance,
+// which always serializes viper.AllSettings() and would therefore re-bake
+// flags, env vars, and the profile overlay into the file as new top-level
+// keys.
+func rewriteConfigFile(mutate func(settings map[string]interface{})) error {
+	path := viper.ConfigFileUsed()
+
+	fileOnly := viper.New()
+	fileOnly.SetConfigFile(path)
+	if err := fileOnly.ReadInConfig(); err != nil {
+		return err
+	}
+
+	settings := fileOnly.AllSettings()
+	mutate(settings)
+
+	out := viper.New()
+	out.SetConfigFile(path)
+	if err := out.MergeConfigMap(settings); err != nil {
+		return err
+	}
+	return out.WriteConfig()
+}
+
+// setNestedKey sets a dotted key in a nested settings map in place,
+// creating intermediate maps as needed. It is the Set-side counterpart to
+// unsetKey.
+func setNestedKey(settings map[string]interface{}, key string, value interface{}) {
+	parts := splitKey(key)
+	m := settings
+	for i, part := range parts {
+		if i == len(parts)-1 {
+			m[part] = value
+			return
+		}
+		next, ok := m[part].(map[string]interface{})
+		if !ok {
+			next = map[string]interface{}{}
+			m[part] = next
+		}
+		m = next
+	}
+}
@@ -0,0 +1,18 @@
+package cmd
+
+import "testing"
+
+func TestEnvVarName(t *testing.T) {
+	cases := map[string]string{
+		"api.url":         "SYNK_API_URL",
+		"api.version":     "SYNK_API_VERSION",
+		"auth.token":      "SYNK_AUTH_TOKEN",
+		"current-profile": "SYNK_CURRENT_PROFILE",
+	}
+
+	for key, want := range cases {
+		if got := envVarName(key); got != want {
+			t.Errorf("envVarName(%q) = %q, want %q", key, got, want)
+		}
+	}
+}
@@ -0,0 +1,107 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"sort"
+
+	"github.com/HelloSapiens/collectivus/synkronus-cli/internal/output"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+var profileCmd = &cobra.Command{
+	Use:   "profile",
+	Short: "Manage named Synkronus environments (dev, staging, prod, ...)",
+	Long:  `Profiles let a single CLI installation target multiple Synkronus deployments without swapping config files.`,
+}
+
+var profileUseCmd = &cobra.Command{
+	Use:   "use <name>",
+	Short: "Select the active profile",
+	Args:  cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		name := args[0]
+		if !viper.IsSet("profiles." + name) {
+			fmt.Fprintf(os.Stderr, "profile %q does not exist, see `synk profile list`\n", name)
+			os.Exit(1)
+		}
+
+		if err := rewriteConfigFile(func(settings map[string]interface{}) {
+			setNestedKey(settings, "current-profile", name)
+		}); err != nil {
+			fmt.Fprintf(os.Stderr, "failed to write config: %v\n", err)
+			os.Exit(1)
+		}
+		viper.Set("current-profile", name)
+
+		if outputFormat() == output.Text {
+			fmt.Printf("active profile set to %s\n", name)
+			return
+		}
+		printResult(map[string]interface{}{"profile": name, "active": true})
+	},
+}
+
+var profileListCmd = &cobra.Command{
+	Use:   "list",
+	Short: "List all configured profiles",
+	Run: func(cmd *cobra.Command, args []string) {
+		current := viper.GetString("current-profile")
+		profiles, _ := viper.Get("profiles").(map[string]interface{})
+
+		names := make([]string, 0, len(profiles))
+		for name := range profiles {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+
+		if outputFormat() == output.Text {
+			for _, name := range names {
+				marker := " "
+				if name == current {
+					marker = "*"
+				}
+				fmt.Printf("%s %s\n", marker, name)
+			}
+			return
+		}
+
+		result := map[string]interface{}{"profiles": names, "active": current}
+		printResult(result)
+	},
+}
+
+var profileAddCmd = &cobra.Command{
+	Use:   "add <name>",
+	Short: "Add a new, empty profile",
+	Args:  cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		name := args[0]
+		if viper.IsSet("profiles." + name) {
+			fmt.Fprintf(os.Stderr, "profile %q already exists\n", name)
+			os.Exit(1)
+		}
+
+		if err := rewriteConfigFile(func(settings map[string]interface{}) {
+			setNestedKey(settings, "profiles."+name, map[string]interface{}{})
+		}); err != nil {
+			fmt.Fprintf(os.Stderr, "failed to write config: %v\n", err)
+			os.Exit(1)
+		}
+		viper.Set("profiles."+name, map[string]interface{}{})
+
+		if outputFormat() == output.Text {
+			fmt.Printf("profile %s added\n", name)
+			return
+		}
+		printResult(map[string]interface{}{"profile": name, "added": true})
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(profileCmd)
+	profileCmd.AddCommand(profileUseCmd)
+	profileCmd.AddCommand(profileListCmd)
+	profileCmd.AddCommand(profileAddCmd)
+}
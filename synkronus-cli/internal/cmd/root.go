@@ -4,15 +4,29 @@ import (
 	"fmt"
 	"os"
 	"path/filepath"
+	"strings"
 
 	"github.com/HelloSapiens/collectivus/synkronus-cli/internal/config"
+	"github.com/HelloSapiens/collectivus/synkronus-cli/internal/logger"
+	"github.com/HelloSapiens/collectivus/synkronus-cli/internal/output"
 	"github.com/HelloSapiens/collectivus/synkronus-cli/internal/utils"
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
 )
 
+// envVars lists the config keys explicitly bound to SYNK_ environment
+// variables, used both to bind them and to drive `synk config env`.
+var envVars = []string{
+	"api.url",
+	"api.version",
+	"auth.token",
+}
+
 var (
 	cfgFile string
+	profile string
+	quiet   bool
+	verbose bool
 	rootCmd = &cobra.Command{
 		Use:   "synk",
 		Short: "Synkronus CLI - A command-line interface for the Synkronus API",
@@ -27,52 +41,81 @@ func Execute() error {
 }
 
 func init() {
-	cobra.OnInitialize(initConfig)
+	cobra.OnInitialize(initLogger, initConfig)
 
-	rootCmd.PersistentFlags().StringVar(&cfgFile, "config", "", "config file (default is $HOME/.synkronus.yaml)")
+	rootCmd.PersistentFlags().StringVar(&cfgFile, "config", "", "config file (default searches ./, $XDG_CONFIG_HOME/synkronus/, $HOME, /etc/synkronus/)")
+	rootCmd.PersistentFlags().StringVar(&profile, "profile", "", "named profile to use (overrides current-profile in the config file)")
 	rootCmd.PersistentFlags().String("api-url", "http://localhost:8080", "Synkronus API URL")
 	rootCmd.PersistentFlags().String("api-version", "1.0.0", "API version to use")
+	rootCmd.PersistentFlags().StringP("output", "o", "text", "output format for command results: text, json, yaml")
+	rootCmd.PersistentFlags().BoolVar(&quiet, "quiet", false, "suppress diagnostic output")
+	rootCmd.PersistentFlags().BoolVar(&verbose, "verbose", false, "show additional diagnostic output")
 
 	viper.BindPFlag("api.url", rootCmd.PersistentFlags().Lookup("api-url"))
 	viper.BindPFlag("api.version", rootCmd.PersistentFlags().Lookup("api-version"))
+	viper.BindPFlag("current-profile", rootCmd.PersistentFlags().Lookup("profile"))
+	viper.BindPFlag("output.format", rootCmd.PersistentFlags().Lookup("output"))
+	viper.BindEnv("current-profile", "SYNK_PROFILE")
 
 	// Apply colored help template
 	utils.SetupColoredHelp(rootCmd)
 }
 
+// initLogger configures the shared stderr logger from --quiet/--verbose.
+func initLogger() {
+	logger.SetLevel(quiet, verbose)
+}
+
+// outputFormat returns the --output/-o format subcommands should render
+// their primary result in.
+func outputFormat() output.Format {
+	return output.Format(viper.GetString("output.format"))
+}
+
+// printResult renders a subcommand's primary result via output.Print,
+// exiting with an error if the requested --output format is invalid (e.g.
+// "-o csv") instead of silently printing nothing.
+func printResult(data interface{}) {
+	if err := output.Print(outputFormat(), data); err != nil {
+		fmt.Fprintf(os.Stderr, "%v\n", err)
+		os.Exit(1)
+	}
+}
+
+// configNames are the basenames searched for in each config path, in
+// priority order: the historical ".synkronus" name, then the "config" name
+// documented for shared/CI installs (e.g. /etc/synkronus/config.toml).
+var configNames = []string{".synkronus", "config"}
+
 func initConfig() {
 	if cfgFile != "" {
-		// Use config file from the flag
+		// Use config file from the flag: exact-file behavior, no search.
 		viper.SetConfigFile(cfgFile)
-	} else {
-		// Find home directory
-		home, err := os.UserHomeDir()
-		cobra.CheckErr(err)
-
-		// Search config in home directory with name ".synkronus" (without extension)
-		viper.AddConfigPath(home)
-		viper.SetConfigType("yaml")
-		viper.SetConfigName(".synkronus")
-
-		// Also look for config in the current directory
-		viper.AddConfigPath(".")
 	}
 
-	// Read in environment variables that match
+	// Read in environment variables that match, under the SYNK_ prefix, with
+	// nested keys mapped as api.url -> SYNK_API_URL.
+	viper.SetEnvPrefix("SYNK")
+	viper.SetEnvKeyReplacer(strings.NewReplacer(".", "_", "-", "_"))
 	viper.AutomaticEnv()
+	for _, key := range envVars {
+		viper.BindEnv(key)
+	}
 
 	// If a config file is found, read it in
-	if err := viper.ReadInConfig(); err == nil {
-		fmt.Printf("Using config file: %s\n", viper.ConfigFileUsed())
+	if err := readConfig(); err == nil {
+		logger.Info("Using config file: %s", viper.ConfigFileUsed())
+		applyProfile()
 	} else {
 		// Create default config if it doesn't exist
 		if _, ok := err.(viper.ConfigFileNotFoundError); ok {
 			defaultConfig := config.DefaultConfig()
-			configDir := filepath.Dir(filepath.Join(os.Getenv("HOME"), ".synkronus.yaml"))
+			configDir := xdgConfigDir()
 			if _, err := os.Stat(configDir); os.IsNotExist(err) {
 				os.MkdirAll(configDir, 0755)
 			}
-			viper.SetConfigFile(filepath.Join(os.Getenv("HOME"), ".synkronus.yaml"))
+			configPath := filepath.Join(configDir, ".synkronus.yaml")
+			viper.SetConfigFile(configPath)
 			for k, v := range defaultConfig {
 				viper.Set(k, v)
 			}
@@ -80,3 +123,90 @@ func initConfig() {
 		}
 	}
 }
+
+// configSearchPaths returns the directories searched for a config file, in
+// priority order: ./, $XDG_CONFIG_HOME/synkronus/ (or ~/.config/synkronus/),
+// $HOME, /etc/synkronus/.
+func configSearchPaths() []string {
+	paths := []string{".", xdgConfigDir()}
+	if home, err := os.UserHomeDir(); err == nil {
+		paths = append(paths, home)
+	}
+	return append(paths, "/etc/synkronus")
+}
+
+// readConfig tries to read the config file. When --config pins an exact
+// file, it reads that file directly. Otherwise it walks configSearchPaths
+// directory by directory, trying every name in configNames within a
+// directory before moving to the next directory -- so a project-local
+// ./config.toml is found before a system-wide /etc/synkronus/.synkronus.yaml,
+// matching the documented ./, XDG, $HOME, /etc/synkronus priority order.
+func readConfig() error {
+	if cfgFile != "" {
+		return viper.ReadInConfig()
+	}
+	return readConfigFrom(configSearchPaths())
+}
+
+// readConfigFrom implements the directory-major search described above for
+// an explicit list of directories, so tests can exercise the priority order
+// without touching $HOME or /etc/synkronus.
+func readConfigFrom(dirs []string) error {
+	for _, dir := range dirs {
+		for _, name := range configNames {
+			probe := viper.New()
+			probe.AddConfigPath(dir)
+			probe.SetConfigName(name)
+			if err := probe.ReadInConfig(); err != nil {
+				if _, ok := err.(viper.ConfigFileNotFoundError); ok {
+					continue
+				}
+				return err
+			}
+
+			viper.SetConfigFile(probe.ConfigFileUsed())
+			return viper.ReadInConfig()
+		}
+	}
+
+	return viper.ConfigFileNotFoundError{}
+}
+
+// applyProfile overlays profiles.<current-profile>.* onto the base config
+// keys (api.url, etc.) so the rest of the CLI never has to know about
+// profiles. It is a no-op when no profiles section or selection exists.
+//
+// The overlay is merged into viper's config-file precedence layer via
+// MergeConfigMap, not set via viper.Set: Set writes to the override
+// register, viper's single highest-precedence layer, which would let a
+// profile's values beat an explicit --api-url flag or SYNK_API_URL env var
+// -- the opposite of the documented flag > env > config file > default
+// precedence.
+func applyProfile() {
+	name := viper.GetString("current-profile")
+	if name == "" {
+		return
+	}
+
+	settings, ok := viper.Get("profiles." + name).(map[string]interface{})
+	if !ok {
+		logger.Warn("profile %q not found", name)
+		return
+	}
+
+	if err := viper.MergeConfigMap(settings); err != nil {
+		logger.Warn("failed to apply profile %q: %v", name, err)
+	}
+}
+
+// xdgConfigDir returns $XDG_CONFIG_HOME/synkronus, falling back to
+// ~/.config/synkronus when XDG_CONFIG_HOME is unset.
+func xdgConfigDir() string {
+	if xdg := os.Getenv("XDG_CONFIG_HOME"); xdg != "" {
+		return filepath.Join(xdg, "synkronus")
+	}
+
+	home, err := os.UserHomeDir()
+	cobra.CheckErr(err)
+	return filepath.Join(home, ".config", "synkronus")
+}
@@ -0,0 +1,62 @@
+package cmd
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/spf13/viper"
+)
+
+func TestXdgConfigDirUsesXDGEnv(t *testing.T) {
+	t.Setenv("XDG_CONFIG_HOME", "/tmp/xdg-test")
+
+	want := filepath.Join("/tmp/xdg-test", "synkronus")
+	if got := xdgConfigDir(); got != want {
+		t.Errorf("xdgConfigDir() = %q, want %q", got, want)
+	}
+}
+
+func TestXdgConfigDirFallsBackToHome(t *testing.T) {
+	t.Setenv("XDG_CONFIG_HOME", "")
+
+	home, err := os.UserHomeDir()
+	if err != nil {
+		t.Skipf("no home directory available: %v", err)
+	}
+
+	want := filepath.Join(home, ".config", "synkronus")
+	if got := xdgConfigDir(); got != want {
+		t.Errorf("xdgConfigDir() = %q, want %q", got, want)
+	}
+}
+
+// TestReadConfigFromIsDirectoryMajor ensures a config file in a
+// higher-priority directory wins even when it uses the lower-priority
+// "config" basename, and a lower-priority directory's file uses the
+// higher-priority ".synkronus" basename. A basename-major search (trying
+// ".synkronus" across every directory before trying "config" anywhere)
+// would pick the second directory's file instead.
+func TestReadConfigFromIsDirectoryMajor(t *testing.T) {
+	projectDir := t.TempDir()
+	systemDir := t.TempDir()
+
+	writeFile(t, filepath.Join(projectDir, "config.yaml"), "api:\n  url: http://project\n")
+	writeFile(t, filepath.Join(systemDir, ".synkronus.yaml"), "api:\n  url: http://system\n")
+
+	if err := readConfigFrom([]string{projectDir, systemDir}); err != nil {
+		t.Fatalf("readConfigFrom returned error: %v", err)
+	}
+
+	want := filepath.Join(projectDir, "config.yaml")
+	if got := viper.ConfigFileUsed(); got != want {
+		t.Errorf("ConfigFileUsed() = %q, want %q (higher-priority directory should win)", got, want)
+	}
+}
+
+func writeFile(t *testing.T, path, content string) {
+	t.Helper()
+	if err := os.WriteFile(path, []byte(content), 0644); err != nil {
+		t.Fatalf("failed to write %s: %v", path, err)
+	}
+}
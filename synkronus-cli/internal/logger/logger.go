@@ -0,0 +1,55 @@
+// Package logger provides the shared diagnostic logger used across the CLI.
+// All human-facing progress and warning messages go through here so they
+// land on stderr, leaving stdout free for the machine-readable output a
+// subcommand renders via internal/output.
+package logger
+
+import (
+	"fmt"
+	"os"
+)
+
+// Level controls how much diagnostic output is emitted.
+type Level int
+
+const (
+	LevelQuiet Level = iota
+	LevelNormal
+	LevelVerbose
+)
+
+var level = LevelNormal
+
+// SetLevel configures the logger from the --quiet/--verbose flags. Quiet
+// takes precedence over verbose if both are set.
+func SetLevel(quiet, verbose bool) {
+	switch {
+	case quiet:
+		level = LevelQuiet
+	case verbose:
+		level = LevelVerbose
+	default:
+		level = LevelNormal
+	}
+}
+
+// Info prints a normal diagnostic message, suppressed in quiet mode.
+func Info(format string, args ...interface{}) {
+	if level < LevelNormal {
+		return
+	}
+	fmt.Fprintf(os.Stderr, format+"\n", args...)
+}
+
+// Warn prints a warning, shown even in quiet mode.
+func Warn(format string, args ...interface{}) {
+	fmt.Fprintf(os.Stderr, "warning: "+format+"\n", args...)
+}
+
+// Debug prints a message only when --verbose is set.
+func Debug(format string, args ...interface{}) {
+	if level < LevelVerbose {
+		return
+	}
+	fmt.Fprintf(os.Stderr, format+"\n", args...)
+}
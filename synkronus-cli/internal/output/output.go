@@ -0,0 +1,46 @@
+// Package output renders a subcommand's primary result in whichever format
+// the user selected with --output/-o, so scripts can reliably pipe
+// `synk ... -o json` into tools like jq while interactive users still get
+// friendly text.
+package output
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+
+	"gopkg.in/yaml.v3"
+)
+
+// Format is a supported rendering mode for --output/-o.
+type Format string
+
+const (
+	Text Format = "text"
+	JSON Format = "json"
+	YAML Format = "yaml"
+)
+
+// Print renders data to stdout in the given format. Text mode uses
+// fmt.Stringer when data implements it, and falls back to "%v" otherwise.
+func Print(format Format, data interface{}) error {
+	switch format {
+	case JSON:
+		enc := json.NewEncoder(os.Stdout)
+		enc.SetIndent("", "  ")
+		return enc.Encode(data)
+	case YAML:
+		enc := yaml.NewEncoder(os.Stdout)
+		defer enc.Close()
+		return enc.Encode(data)
+	case Text, "":
+		if s, ok := data.(fmt.Stringer); ok {
+			fmt.Println(s.String())
+			return nil
+		}
+		fmt.Printf("%v\n", data)
+		return nil
+	default:
+		return fmt.Errorf("unknown output format %q", format)
+	}
+}